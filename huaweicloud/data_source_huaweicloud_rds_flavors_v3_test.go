@@ -11,8 +11,8 @@ import (
 
 func TestAccHuaweiCloudRdsFlavorV3DataSource_basic(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProviders,
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHuaweiCloudRdsFlavorV3DataSource_basic,
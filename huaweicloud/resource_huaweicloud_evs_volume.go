@@ -0,0 +1,276 @@
+package huaweicloud
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk/openstack/evs/v2/cloudvolumes"
+)
+
+func resourceEvsVolume() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEvsVolumeCreate,
+		ReadContext:   resourceEvsVolumeRead,
+		UpdateContext: resourceEvsVolumeUpdate,
+		DeleteContext: resourceEvsVolumeDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"volume_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"snapshot_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"backup_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"multiattach": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceEvsVolumeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	evsV2Client, err := config.evsV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud evs client: %s", err)
+	}
+
+	createOpts := &cloudvolumes.CreateOpts{
+		Volume: cloudvolumes.VolumeOpts{
+			AvailabilityZone:    d.Get("availability_zone").(string),
+			VolumeType:          d.Get("volume_type").(string),
+			Name:                d.Get("name").(string),
+			Description:         d.Get("description").(string),
+			Size:                d.Get("size").(int),
+			BackupID:            d.Get("backup_id").(string),
+			SnapshotID:          d.Get("snapshot_id").(string),
+			ImageID:             d.Get("image_id").(string),
+			Multiattach:         d.Get("multiattach").(bool),
+			Metadata:            expandEvsVolumeMap(d.Get("metadata").(map[string]interface{})),
+			Tags:                expandEvsVolumeMap(d.Get("tags").(map[string]interface{})),
+			EnterpriseProjectID: d.Get("enterprise_project_id").(string),
+		},
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	job, err := cloudvolumes.Create(evsV2Client, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating EVS Volume: %s", err)
+	}
+
+	job, err = cloudvolumes.WaitForJobSuccess(evsV2Client, job.JobID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("Error waiting for EVS Volume to become available: %s", err)
+	}
+
+	d.SetId(job.Entities.VolumeID)
+
+	return resourceEvsVolumeRead(ctx, d, meta)
+}
+
+func resourceEvsVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	evsV2Client, err := config.evsV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud evs client: %s", err)
+	}
+
+	volume, err := cloudvolumes.Get(evsV2Client, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "EVS Volume"))
+	}
+
+	d.Set("name", volume.Name)
+	d.Set("description", volume.Description)
+	d.Set("availability_zone", volume.AvailabilityZone)
+	d.Set("volume_type", volume.VolumeType)
+	d.Set("size", volume.Size)
+	d.Set("snapshot_id", volume.SnapshotID)
+	d.Set("multiattach", volume.Multiattach)
+	d.Set("enterprise_project_id", volume.EnterpriseProjectID)
+	d.Set("metadata", volume.Metadata)
+	d.Set("tags", volume.Tags)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceEvsVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	evsV2Client, err := config.evsV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud evs client: %s", err)
+	}
+
+	if d.HasChanges("name", "description") {
+		description := d.Get("description").(string)
+		updateOpts := cloudvolumes.UpdateOpts{
+			Name:        d.Get("name").(string),
+			Description: &description,
+		}
+
+		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+		if _, err := cloudvolumes.Update(evsV2Client, d.Id(), updateOpts).Extract(); err != nil {
+			return diag.Errorf("Error updating EVS Volume: %s", err)
+		}
+	}
+
+	if d.HasChange("size") {
+		extendOpts := cloudvolumes.ExtendOpts{
+			SizeOpts: cloudvolumes.ExtendSizeOpts{
+				NewSize: d.Get("size").(int),
+			},
+		}
+
+		job, err := cloudvolumes.ExtendSize(evsV2Client, d.Id(), extendOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error extending size of EVS Volume %s: %s", d.Id(), err)
+		}
+		if _, err := cloudvolumes.WaitForJobSuccess(evsV2Client, job.JobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("Error waiting for EVS Volume %s to finish extending: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("metadata") {
+		metadata := expandEvsVolumeMap(d.Get("metadata").(map[string]interface{}))
+		if _, err := cloudvolumes.UpdateMetadata(evsV2Client, d.Id(), metadata).Extract(); err != nil {
+			return diag.Errorf("Error updating metadata of EVS Volume %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+		oldMap := oldTags.(map[string]interface{})
+		newMap := newTags.(map[string]interface{})
+
+		toDelete := map[string]interface{}{}
+		for key, value := range oldMap {
+			if newValue, ok := newMap[key]; !ok || newValue != value {
+				toDelete[key] = value
+			}
+		}
+		toCreate := map[string]interface{}{}
+		for key, value := range newMap {
+			if oldValue, ok := oldMap[key]; !ok || oldValue != value {
+				toCreate[key] = value
+			}
+		}
+
+		if len(toDelete) > 0 {
+			if err := cloudvolumes.DeleteTags(evsV2Client, d.Id(), expandEvsVolumeTags(toDelete)).ExtractErr(); err != nil {
+				return diag.Errorf("Error deleting tags on EVS Volume %s: %s", d.Id(), err)
+			}
+		}
+		if len(toCreate) > 0 {
+			if err := cloudvolumes.CreateTags(evsV2Client, d.Id(), expandEvsVolumeTags(toCreate)).ExtractErr(); err != nil {
+				return diag.Errorf("Error setting tags on EVS Volume %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceEvsVolumeRead(ctx, d, meta)
+}
+
+func resourceEvsVolumeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	evsV2Client, err := config.evsV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud evs client: %s", err)
+	}
+
+	if err := cloudvolumes.Delete(evsV2Client, d.Id(), nil).ExtractErr(); err != nil {
+		return diag.Errorf("Error deleting EVS Volume: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandEvsVolumeMap(raw map[string]interface{}) map[string]string {
+	m := make(map[string]string, len(raw))
+	for key, value := range raw {
+		m[key] = value.(string)
+	}
+	return m
+}
+
+func expandEvsVolumeTags(raw map[string]interface{}) []cloudvolumes.Tag {
+	tagList := make([]cloudvolumes.Tag, 0, len(raw))
+	for key, value := range raw {
+		tagList = append(tagList, cloudvolumes.Tag{
+			Key:   key,
+			Value: value.(string),
+		})
+	}
+	return tagList
+}
@@ -0,0 +1,245 @@
+package huaweicloud
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/vpc/v2/dnatrules"
+)
+
+func resourceNatDnatRuleV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNatDnatRuleV2Create,
+		ReadContext:   resourceNatDnatRuleV2Read,
+		UpdateContext: resourceNatDnatRuleV2Update,
+		DeleteContext: resourceNatDnatRuleV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"nat_gateway_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"floating_ip_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"internal_service_port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: false,
+			},
+			"external_service_port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: false,
+			},
+			"port_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"private_ip"},
+			},
+			"private_ip": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"port_id"},
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+		},
+	}
+}
+
+func resourceNatDnatRuleV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	vpcV2Client, err := config.vpcV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	createOpts := &dnatrules.CreateOpts{
+		NatGatewayID:        d.Get("nat_gateway_id").(string),
+		FloatingIpID:        d.Get("floating_ip_id").(string),
+		Protocol:            d.Get("protocol").(string),
+		InternalServicePort: d.Get("internal_service_port").(int),
+		ExternalServicePort: d.Get("external_service_port").(int),
+		PortID:              d.Get("port_id").(string),
+		PrivateIp:           d.Get("private_ip").(string),
+		Description:         d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	dnatRule, err := dnatrules.Create(vpcV2Client, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud DNAT Rule: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for HuaweiCloud DNAT Rule (%s) to become available.", dnatRule.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Refresh:    waitForNatDnatRuleActive(vpcV2Client, dnatRule.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud DNAT Rule: %s", err)
+	}
+
+	d.SetId(dnatRule.ID)
+
+	return resourceNatDnatRuleV2Read(ctx, d, meta)
+}
+
+func resourceNatDnatRuleV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	vpcV2Client, err := config.vpcV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	dnatRule, err := dnatrules.Get(vpcV2Client, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "DNAT Rule"))
+	}
+
+	d.Set("nat_gateway_id", dnatRule.NatGatewayID)
+	d.Set("floating_ip_id", dnatRule.FloatingIpID)
+	d.Set("protocol", dnatRule.Protocol)
+	d.Set("internal_service_port", dnatRule.InternalServicePort)
+	d.Set("external_service_port", dnatRule.ExternalServicePort)
+	d.Set("port_id", dnatRule.PortID)
+	d.Set("private_ip", dnatRule.PrivateIp)
+	d.Set("description", dnatRule.Description)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNatDnatRuleV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	vpcV2Client, err := config.vpcV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	if d.HasChanges("protocol", "internal_service_port", "external_service_port", "description") {
+		description := d.Get("description").(string)
+		internalServicePort := d.Get("internal_service_port").(int)
+		externalServicePort := d.Get("external_service_port").(int)
+		updateOpts := dnatrules.UpdateOpts{
+			Protocol:            d.Get("protocol").(string),
+			InternalServicePort: &internalServicePort,
+			ExternalServicePort: &externalServicePort,
+			Description:         &description,
+		}
+
+		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+		if _, err := dnatrules.Update(vpcV2Client, d.Id(), updateOpts).Extract(); err != nil {
+			return diag.Errorf("Error updating HuaweiCloud DNAT Rule: %s", err)
+		}
+	}
+
+	return resourceNatDnatRuleV2Read(ctx, d, meta)
+}
+
+func resourceNatDnatRuleV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	vpcV2Client, err := config.vpcV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForNatDnatRuleDelete(vpcV2Client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error deleting HuaweiCloud DNAT Rule: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForNatDnatRuleActive(vpcV2Client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		n, err := dnatrules.Get(vpcV2Client, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] HuaweiCloud DNAT Rule: %+v", n)
+		if n.Status == "ACTIVE" {
+			return n, "ACTIVE", nil
+		}
+
+		return n, "", nil
+	}
+}
+
+func waitForNatDnatRuleDelete(vpcV2Client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Attempting to delete HuaweiCloud DNAT Rule %s.\n", id)
+
+		n, err := dnatrules.Get(vpcV2Client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted HuaweiCloud DNAT Rule %s", id)
+				return n, "DELETED", nil
+			}
+			return n, "ACTIVE", err
+		}
+
+		err = dnatrules.Delete(vpcV2Client, id).ExtractErr()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted HuaweiCloud DNAT Rule %s", id)
+				return n, "DELETED", nil
+			}
+			return n, "ACTIVE", err
+		}
+
+		log.Printf("[DEBUG] HuaweiCloud DNAT Rule %s still active.\n", id)
+		return n, "ACTIVE", nil
+	}
+}
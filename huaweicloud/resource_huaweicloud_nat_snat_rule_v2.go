@@ -0,0 +1,219 @@
+package huaweicloud
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/vpc/v2/snatrules"
+)
+
+func resourceNatSnatRuleV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNatSnatRuleV2Create,
+		ReadContext:   resourceNatSnatRuleV2Read,
+		UpdateContext: resourceNatSnatRuleV2Update,
+		DeleteContext: resourceNatSnatRuleV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"nat_gateway_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"floating_ip_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cidr"},
+			},
+			"cidr": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"subnet_id"},
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+		},
+	}
+}
+
+func resourceNatSnatRuleV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	vpcV2Client, err := config.vpcV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	createOpts := &snatrules.CreateOpts{
+		NatGatewayID: d.Get("nat_gateway_id").(string),
+		FloatingIpID: d.Get("floating_ip_id").(string),
+		NetworkID:    d.Get("subnet_id").(string),
+		Cidr:         d.Get("cidr").(string),
+		Description:  d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	snatRule, err := snatrules.Create(vpcV2Client, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud SNAT Rule: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for HuaweiCloud SNAT Rule (%s) to become available.", snatRule.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Refresh:    waitForNatSnatRuleActive(vpcV2Client, snatRule.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud SNAT Rule: %s", err)
+	}
+
+	d.SetId(snatRule.ID)
+
+	return resourceNatSnatRuleV2Read(ctx, d, meta)
+}
+
+func resourceNatSnatRuleV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	vpcV2Client, err := config.vpcV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	snatRule, err := snatrules.Get(vpcV2Client, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "SNAT Rule"))
+	}
+
+	d.Set("nat_gateway_id", snatRule.NatGatewayID)
+	d.Set("floating_ip_id", snatRule.FloatingIpID)
+	d.Set("subnet_id", snatRule.NetworkID)
+	d.Set("cidr", snatRule.Cidr)
+	d.Set("description", snatRule.Description)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNatSnatRuleV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	vpcV2Client, err := config.vpcV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts := snatrules.UpdateOpts{
+			Description: &description,
+		}
+
+		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+		if _, err := snatrules.Update(vpcV2Client, d.Id(), updateOpts).Extract(); err != nil {
+			return diag.Errorf("Error updating HuaweiCloud SNAT Rule: %s", err)
+		}
+	}
+
+	return resourceNatSnatRuleV2Read(ctx, d, meta)
+}
+
+func resourceNatSnatRuleV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	vpcV2Client, err := config.vpcV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForNatSnatRuleDelete(vpcV2Client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error deleting HuaweiCloud SNAT Rule: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForNatSnatRuleActive(vpcV2Client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		n, err := snatrules.Get(vpcV2Client, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] HuaweiCloud SNAT Rule: %+v", n)
+		if n.Status == "ACTIVE" {
+			return n, "ACTIVE", nil
+		}
+
+		return n, "", nil
+	}
+}
+
+func waitForNatSnatRuleDelete(vpcV2Client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Attempting to delete HuaweiCloud SNAT Rule %s.\n", id)
+
+		n, err := snatrules.Get(vpcV2Client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted HuaweiCloud SNAT Rule %s", id)
+				return n, "DELETED", nil
+			}
+			return n, "ACTIVE", err
+		}
+
+		err = snatrules.Delete(vpcV2Client, id).ExtractErr()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted HuaweiCloud SNAT Rule %s", id)
+				return n, "DELETED", nil
+			}
+			return n, "ACTIVE", err
+		}
+
+		log.Printf("[DEBUG] HuaweiCloud SNAT Rule %s still active.\n", id)
+		return n, "ACTIVE", nil
+	}
+}
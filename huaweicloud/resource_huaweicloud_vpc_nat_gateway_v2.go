@@ -1,23 +1,27 @@
 package huaweicloud
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/resource"
-	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	"github.com/huawei-clouds/golangsdk"
-	"github.com/huawei-clouds/golangsdk/openstack/vpc/v2/natgateways"
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/common/structs"
+	"github.com/chnsz/golangsdk/openstack/vpc/v2/natgateways"
+	"github.com/chnsz/golangsdk/openstack/vpc/v2/natgateways/tags"
 )
 
 func resourceVpcNatGatewayV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceVpcNatGatewayV2Create,
-		Read:   resourceVpcNatGatewayV2Read,
-		Update: resourceVpcNatGatewayV2Update,
-		Delete: resourceVpcNatGatewayV2Delete,
+		CreateContext: resourceVpcNatGatewayV2Create,
+		ReadContext:   resourceVpcNatGatewayV2Read,
+		UpdateContext: resourceVpcNatGatewayV2Update,
+		DeleteContext: resourceVpcNatGatewayV2Delete,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -46,7 +50,7 @@ func resourceVpcNatGatewayV2() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     false,
-				ValidateFunc: resourceNatGatewayV2ValidateSpec,
+				ValidateFunc: validation.StringInSlice(Specs, false),
 			},
 			"tenant_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -64,30 +68,89 @@ func resourceVpcNatGatewayV2() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"enterprise_project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"charging_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"prePaid", "postPaid"}, false),
+			},
+			"period_unit": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"month", "year"}, false),
+			},
+			"period": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"auto_renew": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
 		},
 	}
 }
 
-func resourceVpcNatGatewayV2Create(d *schema.ResourceData, meta interface{}) error {
+// resourceVpcNatGatewayV2ChargeInfo builds the prePaid billing parameters
+// for Create. It returns nil for postPaid gateways, which carry no
+// bssParam. IsAutoPay is always set to "true" for prePaid so that the
+// gateway's order is paid and completed automatically as part of Create,
+// since terraform-apply has no path to confirm an order interactively.
+func resourceVpcNatGatewayV2ChargeInfo(d *schema.ResourceData) *structs.ChargeInfo {
+	if d.Get("charging_mode").(string) != "prePaid" {
+		return nil
+	}
+
+	chargeInfo := &structs.ChargeInfo{
+		ChargingMode: d.Get("charging_mode").(string),
+		PeriodType:   d.Get("period_unit").(string),
+		PeriodNum:    d.Get("period").(int),
+		IsAutoPay:    "true",
+	}
+	if d.Get("auto_renew").(bool) {
+		chargeInfo.IsAutoRenew = "true"
+	}
+
+	return chargeInfo
+}
+
+func resourceVpcNatGatewayV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	vpcV2Client, err := config.vpcV2Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+		return diag.Errorf("Error creating HuaweiCloud vpc client: %s", err)
 	}
 
 	createOpts := &natgateways.CreateOpts{
-		Name:              d.Get("name").(string),
-		Description:       d.Get("description").(string),
-		Spec:              d.Get("spec").(string),
-		TenantID:          d.Get("tenant_id").(string),
-		RouterID:          d.Get("router_id").(string),
-		InternalNetworkID: d.Get("internal_network_id").(string),
+		Name:                d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		Spec:                d.Get("spec").(string),
+		TenantID:            d.Get("tenant_id").(string),
+		RouterID:            d.Get("router_id").(string),
+		InternalNetworkID:   d.Get("internal_network_id").(string),
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
+		ChargeInfo:          resourceVpcNatGatewayV2ChargeInfo(d),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	natGateway, err := natgateways.Create(vpcV2Client, createOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("Error creatting Nat Gateway: %s", err)
+		return diag.Errorf("Error creatting Nat Gateway: %s", err)
 	}
 
 	log.Printf("[DEBUG] Waiting for HuaweiCloud Nat Gateway (%s) to become available.", natGateway.ID)
@@ -100,26 +163,36 @@ func resourceVpcNatGatewayV2Create(d *schema.ResourceData, meta interface{}) err
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf("Error creating HuaweiCloud Nat Gateway: %s", err)
+		return diag.Errorf("Error creating HuaweiCloud Nat Gateway: %s", err)
 	}
 
 	d.SetId(natGateway.ID)
 
-	return resourceVpcNatGatewayV2Read(d, meta)
+	if rawTags := d.Get("tags").(map[string]interface{}); len(rawTags) > 0 {
+		createTagsOpts := tags.BatchOpts{
+			Action: "create",
+			Tags:   expandNatGatewayV2Tags(rawTags),
+		}
+		if err := tags.Action(vpcV2Client, natGateway.ID, createTagsOpts).ExtractErr(); err != nil {
+			return diag.Errorf("Error setting tags on HuaweiCloud Nat Gateway %s: %s", natGateway.ID, err)
+		}
+	}
+
+	return resourceVpcNatGatewayV2Read(ctx, d, meta)
 }
 
-func resourceVpcNatGatewayV2Read(d *schema.ResourceData, meta interface{}) error {
+func resourceVpcNatGatewayV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	vpcV2Client, err := config.vpcV2Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+		return diag.Errorf("Error creating HuaweiCloud vpc client: %s", err)
 	}
 
 	natGateway, err := natgateways.Get(vpcV2Client, d.Id()).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "Nat Gateway")
+		return diag.FromErr(CheckDeleted(d, err, "Nat Gateway"))
 	}
 
 	d.Set("name", natGateway.Name)
@@ -128,46 +201,112 @@ func resourceVpcNatGatewayV2Read(d *schema.ResourceData, meta interface{}) error
 	d.Set("router_id", natGateway.RouterID)
 	d.Set("internal_network_id", natGateway.InternalNetworkID)
 	d.Set("tenant_id", natGateway.TenantID)
+	d.Set("enterprise_project_id", natGateway.EnterpriseProjectID)
+
+	natGatewayTags, err := tags.Get(vpcV2Client, d.Id()).Extract()
+	if err != nil {
+		return diag.Errorf("Error fetching tags of HuaweiCloud Nat Gateway %s: %s", d.Id(), err)
+	}
+	d.Set("tags", flattenNatGatewayV2Tags(natGatewayTags))
 
 	d.Set("region", GetRegion(d, config))
 
 	return nil
 }
 
-func resourceVpcNatGatewayV2Update(d *schema.ResourceData, meta interface{}) error {
+func resourceVpcNatGatewayV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	vpcV2Client, err := config.vpcV2Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+		return diag.Errorf("Error creating HuaweiCloud vpc client: %s", err)
 	}
 
-	var updateOpts natgateways.UpdateOpts
+	if d.HasChanges("name", "description", "spec") {
+		var updateOpts natgateways.UpdateOpts
 
-	if d.HasChange("name") {
-		updateOpts.Name = d.Get("name").(string)
-	}
-	if d.HasChange("description") {
-		updateOpts.Description = d.Get("description").(string)
+		if d.HasChange("name") {
+			updateOpts.Name = d.Get("name").(string)
+		}
+		if d.HasChange("description") {
+			updateOpts.Description = d.Get("description").(string)
+		}
+		if d.HasChange("spec") {
+			updateOpts.Spec = d.Get("spec").(string)
+		}
+
+		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+
+		_, err = natgateways.Update(vpcV2Client, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating Nat Gateway: %s", err)
+		}
 	}
-	if d.HasChange("spec") {
-		updateOpts.Spec = d.Get("spec").(string)
+
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+		oldMap := oldTags.(map[string]interface{})
+		newMap := newTags.(map[string]interface{})
+
+		toDelete := map[string]interface{}{}
+		for key, value := range oldMap {
+			if newValue, ok := newMap[key]; !ok || newValue != value {
+				toDelete[key] = value
+			}
+		}
+		toCreate := map[string]interface{}{}
+		for key, value := range newMap {
+			if oldValue, ok := oldMap[key]; !ok || oldValue != value {
+				toCreate[key] = value
+			}
+		}
+
+		if len(toDelete) > 0 {
+			deleteTagsOpts := tags.BatchOpts{
+				Action: "delete",
+				Tags:   expandNatGatewayV2Tags(toDelete),
+			}
+			if err := tags.Action(vpcV2Client, d.Id(), deleteTagsOpts).ExtractErr(); err != nil {
+				return diag.Errorf("Error deleting tags on HuaweiCloud Nat Gateway %s: %s", d.Id(), err)
+			}
+		}
+		if len(toCreate) > 0 {
+			createTagsOpts := tags.BatchOpts{
+				Action: "create",
+				Tags:   expandNatGatewayV2Tags(toCreate),
+			}
+			if err := tags.Action(vpcV2Client, d.Id(), createTagsOpts).ExtractErr(); err != nil {
+				return diag.Errorf("Error setting tags on HuaweiCloud Nat Gateway %s: %s", d.Id(), err)
+			}
+		}
 	}
 
-	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	return resourceVpcNatGatewayV2Read(ctx, d, meta)
+}
 
-	_, err = natgateways.Update(vpcV2Client, d.Id(), updateOpts).Extract()
-	if err != nil {
-		return fmt.Errorf("Error updating Nat Gateway: %s", err)
+func expandNatGatewayV2Tags(rawTags map[string]interface{}) []tags.Tag {
+	tagList := make([]tags.Tag, 0, len(rawTags))
+	for key, value := range rawTags {
+		tagList = append(tagList, tags.Tag{
+			Key:   key,
+			Value: value.(string),
+		})
 	}
+	return tagList
+}
 
-	return resourceVpcNatGatewayV2Read(d, meta)
+func flattenNatGatewayV2Tags(tagList []tags.Tag) map[string]string {
+	tagMap := make(map[string]string, len(tagList))
+	for _, tag := range tagList {
+		tagMap[tag.Key] = tag.Value
+	}
+	return tagMap
 }
 
-func resourceVpcNatGatewayV2Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceVpcNatGatewayV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	vpcV2Client, err := config.vpcV2Client(GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("Error creating HuaweiCloud vpc client: %s", err)
+		return diag.Errorf("Error creating HuaweiCloud vpc client: %s", err)
 	}
 
 	stateConf := &resource.StateChangeConf{
@@ -179,9 +318,9 @@ func resourceVpcNatGatewayV2Delete(d *schema.ResourceData, meta interface{}) err
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf("Error deleting HuaweiCloud Nat Gateway: %s", err)
+		return diag.Errorf("Error deleting HuaweiCloud Nat Gateway: %s", err)
 	}
 
 	d.SetId("")
@@ -231,15 +370,4 @@ func waitForNatGatewayDelete(vpcV2Client *golangsdk.ServiceClient, nId string) r
 	}
 }
 
-var Specs = [4]string{"1", "2", "3", "4"}
-
-func resourceNatGatewayV2ValidateSpec(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-	for i := range Specs {
-		if value == Specs[i] {
-			return
-		}
-	}
-	errors = append(errors, fmt.Errorf("%q must be one of %v", k, Specs))
-	return
-}
+var Specs = []string{"1", "2", "3", "4", "Small", "Medium", "Large", "Extra-large"}
@@ -0,0 +1,19 @@
+package structs
+
+// ChargeInfo carries the prePaid billing parameters (bssParam) shared by the
+// resources that can be ordered with yearly/monthly billing. It is embedded
+// in the CreateOpts of those resources under the "bssParam" key.
+type ChargeInfo struct {
+	// ChargingMode is either "prePaid" or "postPaid".
+	ChargingMode string `json:"charging_mode,omitempty"`
+	// PeriodType is either "month" or "year".
+	PeriodType string `json:"period_type,omitempty"`
+	// PeriodNum is the number of months/years to charge for.
+	PeriodNum int `json:"period_num,omitempty"`
+	// IsAutoRenew specifies whether to automatically renew the
+	// subscription once it expires, "true" or "false".
+	IsAutoRenew string `json:"is_auto_renew,omitempty"`
+	// IsAutoPay specifies whether to automatically pay for the order from
+	// the customer's account balance, "true" or "false".
+	IsAutoPay string `json:"is_auto_pay,omitempty"`
+}
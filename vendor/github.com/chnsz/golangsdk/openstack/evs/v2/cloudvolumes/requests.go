@@ -1,7 +1,9 @@
 package cloudvolumes
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/chnsz/golangsdk"
 	"github.com/chnsz/golangsdk/openstack/common/structs"
@@ -224,6 +226,18 @@ type ListOpts struct {
 	// Metadata will filter results based on specified metadata.
 	Metadata map[string]string `q:"metadata"`
 
+	// Tags will filter results to volumes that have all of the specified
+	// tag key/value pairs.
+	Tags map[string]string `q:"tags"`
+
+	// NotTags will filter results to volumes that have none of the
+	// specified tag key/value pairs.
+	NotTags map[string]string `q:"not_tags"`
+
+	// TagsAny will filter results to volumes that have at least one of the
+	// specified tag key/value pairs.
+	TagsAny map[string]string `q:"tags_any"`
+
 	ID string `q:"id"`
 
 	ServerID string `q:"server_id"`
@@ -262,3 +276,140 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Page
 		return VolumePage{pagination.LinkedPageBase{PageResult: r}}
 	})
 }
+
+// JobPollInterval is the delay between job status checks in WaitForJobSuccess.
+// It is a variable so tests can shorten it.
+var JobPollInterval = 5 * time.Second
+
+// GetJob retrieves the asynchronous job with the provided ID. To extract
+// the Job from the response, call the Extract method on the JobResult.
+func GetJob(client *golangsdk.ServiceClient, jobID string) (r JobResult) {
+	_, r.Err = client.Get(jobURL(client, jobID), &r.Body, nil)
+	return
+}
+
+// WaitForJobSuccess polls the job with the provided ID until it reaches the
+// SUCCESS status, the FAIL status, or the timeout elapses. On success it
+// returns the completed Job, whose Entities carry the ID(s) of the
+// volume(s) the job produced. On failure it returns an error that includes
+// the job's fail_reason.
+func WaitForJobSuccess(client *golangsdk.ServiceClient, jobID string, timeout time.Duration) (*Job, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		job, err := GetJob(client, jobID).Extract()
+		if err != nil {
+			return nil, err
+		}
+
+		switch job.Status {
+		case "SUCCESS":
+			return job, nil
+		case "FAIL":
+			return nil, fmt.Errorf("job %s failed: %s", jobID, job.FailReason)
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timeout waiting for job %s to complete", jobID)
+		}
+
+		time.Sleep(JobPollInterval)
+	}
+}
+
+// GetMetadata retrieves all metadata items for the Volume with the provided
+// ID. To extract the metadata map from the response, call the Extract
+// method on the MetadataResult.
+func GetMetadata(client *golangsdk.ServiceClient, id string) (r MetadataResult) {
+	_, r.Err = client.Get(metadataURL(client, id), &r.Body, nil)
+	return
+}
+
+// UpdateMetadata reconciles the metadata of the Volume with the provided ID
+// to match the given map, replacing the previous contents entirely. To
+// extract the resulting metadata map from the response, call the Extract
+// method on the MetadataResult.
+func UpdateMetadata(client *golangsdk.ServiceClient, id string, metadata map[string]string) (r MetadataResult) {
+	b := map[string]interface{}{"metadata": metadata}
+	_, r.Err = client.Put(metadataURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteMetadataItem will permanently delete the metadata item identified
+// by key from the Volume with the provided ID.
+func DeleteMetadataItem(client *golangsdk.ServiceClient, id, key string) (r DeleteResult) {
+	_, r.Err = client.Delete(metadataItemURL(client, id, key), &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ShowTags retrieves the tags attached to the Volume with the provided ID.
+// To extract the tags from the response, call the Extract method on the
+// TagsResult.
+func ShowTags(client *golangsdk.ServiceClient, id string) (r TagsResult) {
+	_, r.Err = client.Get(tagsURL(client, id), &r.Body, nil)
+	return
+}
+
+// ListTags retrieves every tag key/value pair in use across the project's
+// volumes. To extract the tags from the response, call the Extract method
+// on the TagsResult.
+func ListTags(client *golangsdk.ServiceClient) (r TagsResult) {
+	_, r.Err = client.Get(allTagsURL(client), &r.Body, nil)
+	return
+}
+
+// CreateTags adds the given tags to the Volume with the provided ID via
+// the batch tag action endpoint. Tag keys that already exist on the volume
+// are overwritten.
+func CreateTags(client *golangsdk.ServiceClient, id string, tags []Tag) (r golangsdk.ErrResult) {
+	b := map[string]interface{}{"action": "create", "tags": tags}
+	_, r.Err = client.Post(tagsActionURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
+
+// DeleteTags removes the given tags from the Volume with the provided ID
+// via the batch tag action endpoint.
+func DeleteTags(client *golangsdk.ServiceClient, id string, tags []Tag) (r golangsdk.ErrResult) {
+	b := map[string]interface{}{"action": "delete", "tags": tags}
+	_, r.Err = client.Post(tagsActionURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
+
+// CreateBatch issues a Create request and, once the returned job reaches
+// SUCCESS, returns the IDs of every Volume the job produced in order. It is
+// the only way to retrieve the resulting volume IDs when CreateOpts.Volume
+// specifies a Count greater than one.
+func CreateBatch(client *golangsdk.ServiceClient, opts CreateOptsBuilder) ([]string, error) {
+	r := Create(client, opts)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	job, err := r.Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	job, err = WaitForJobSuccess(client, job.JobID, 30*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(job.Entities.SubJobs) == 0 {
+		return []string{job.Entities.VolumeID}, nil
+	}
+
+	volumeIDs := make([]string, len(job.Entities.SubJobs))
+	for i, subJob := range job.Entities.SubJobs {
+		volumeIDs[i] = subJob.Entities.VolumeID
+	}
+	return volumeIDs, nil
+}
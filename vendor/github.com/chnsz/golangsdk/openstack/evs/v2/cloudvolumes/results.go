@@ -0,0 +1,175 @@
+package cloudvolumes
+
+import (
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/pagination"
+)
+
+// Attachment represents a Volume attachment.
+type Attachment struct {
+	AttachedAt   string `json:"attached_at"`
+	AttachmentID string `json:"attachment_id"`
+	Device       string `json:"device"`
+	HostName     string `json:"host_name"`
+	ID           string `json:"id"`
+	ServerID     string `json:"server_id"`
+	VolumeID     string `json:"volume_id"`
+}
+
+// Volume contains all the information associated with an EVS volume.
+type Volume struct {
+	ID                  string            `json:"id"`
+	Name                string            `json:"name"`
+	Description         string            `json:"description"`
+	Status              string            `json:"status"`
+	Size                int               `json:"size"`
+	VolumeType          string            `json:"volume_type"`
+	AvailabilityZone    string            `json:"availability_zone"`
+	Multiattach         bool              `json:"multiattach"`
+	Bootable            string            `json:"bootable"`
+	Encrypted           bool              `json:"encrypted"`
+	Metadata            map[string]string `json:"metadata"`
+	Tags                map[string]string `json:"tags"`
+	Attachments         []Attachment      `json:"attachments"`
+	SnapshotID          string            `json:"snapshot_id"`
+	SourceVolID         string            `json:"source_volid"`
+	EnterpriseProjectID string            `json:"enterprise_project_id"`
+	CreatedAt           string            `json:"created_at"`
+	UpdatedAt           string            `json:"updated_at"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a commonResult as a Volume.
+func (r commonResult) Extract() (*Volume, error) {
+	var s struct {
+		Volume Volume `json:"volume"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Volume, err
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Volume.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its
+// Extract method to interpret it as a Volume.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// VolumePage is a single page of Volume results.
+type VolumePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a VolumePage contains no Volume results.
+func (r VolumePage) IsEmpty() (bool, error) {
+	volumes, err := ExtractVolumes(r)
+	return len(volumes) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to
+// the next page of results.
+func (r VolumePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []golangsdk.Link `json:"volumes_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return golangsdk.ExtractNextURL(s.Links)
+}
+
+// ExtractVolumes extracts and returns Volumes from a List operation.
+func ExtractVolumes(r pagination.Page) ([]Volume, error) {
+	var s struct {
+		Volumes []Volume `json:"volumes"`
+	}
+	err := (r.(VolumePage)).ExtractInto(&s)
+	return s.Volumes, err
+}
+
+// JobEntities contains the resources produced or affected by a Job.
+type JobEntities struct {
+	VolumeID     string `json:"volume_id,omitempty"`
+	SubJobsTotal int    `json:"sub_jobs_total,omitempty"`
+	SubJobs      []Job  `json:"sub_jobs,omitempty"`
+}
+
+// Job represents an asynchronous EVS job, as returned by the jobs endpoint
+// and by operations (such as Create and ExtendSize) that run asynchronously.
+type Job struct {
+	// Status is one of RUNNING, SUCCESS, or FAIL.
+	Status     string      `json:"status"`
+	Entities   JobEntities `json:"entities"`
+	JobID      string      `json:"job_id"`
+	JobType    string      `json:"job_type"`
+	BeginTime  string      `json:"begin_time"`
+	EndTime    string      `json:"end_time"`
+	ErrorCode  string      `json:"error_code"`
+	FailReason string      `json:"fail_reason"`
+	Message    string      `json:"message"`
+}
+
+// JobResult represents the result of an operation that returns a Job, such
+// as Create, ExtendSize, and GetJob. Call its Extract method to interpret
+// it as a Job.
+type JobResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a JobResult as a Job.
+func (r JobResult) Extract() (*Job, error) {
+	job := new(Job)
+	err := r.ExtractInto(job)
+	return job, err
+}
+
+// MetadataResult represents the result of a GetMetadata or UpdateMetadata
+// operation. Call its Extract method to interpret it as a metadata map.
+type MetadataResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a MetadataResult as a map of metadata.
+func (r MetadataResult) Extract() (map[string]string, error) {
+	var s struct {
+		Metadata map[string]string `json:"metadata"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Metadata, err
+}
+
+// Tag represents a single key/value tag attached to a Volume.
+type Tag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// TagsResult represents the result of a ShowTags or ListTags operation.
+// Call its Extract method to interpret it as a slice of Tags.
+type TagsResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a TagsResult as a slice of Tags.
+func (r TagsResult) Extract() ([]Tag, error) {
+	var s struct {
+		Tags []Tag `json:"tags"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Tags, err
+}
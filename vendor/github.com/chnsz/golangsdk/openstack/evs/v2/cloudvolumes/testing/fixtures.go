@@ -0,0 +1,130 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/chnsz/golangsdk/testhelper"
+	"github.com/chnsz/golangsdk/testhelper/client"
+)
+
+// JobID is the job ID used by the fixtures in this package.
+const JobID = "2bff9d25-4f7f-4284-a5d6-ca8a6b808e09"
+
+const jobRunningBody = `
+{
+    "status": "RUNNING",
+    "entities": {},
+    "job_id": "` + JobID + `",
+    "job_type": "createVolume",
+    "begin_time": "2021-01-01T00:00:00Z"
+}`
+
+const jobSuccessBody = `
+{
+    "status": "SUCCESS",
+    "entities": {
+        "volume_id": "591a2fb2-5f46-4b99-9653-b9f06c24e63d"
+    },
+    "job_id": "` + JobID + `",
+    "job_type": "createVolume",
+    "begin_time": "2021-01-01T00:00:00Z",
+    "end_time": "2021-01-01T00:01:00Z"
+}`
+
+const jobFailBody = `
+{
+    "status": "FAIL",
+    "entities": {},
+    "job_id": "` + JobID + `",
+    "job_type": "createVolume",
+    "fail_reason": "quota exceeded",
+    "begin_time": "2021-01-01T00:00:00Z",
+    "end_time": "2021-01-01T00:01:00Z"
+}`
+
+const jobBatchSuccessBody = `
+{
+    "status": "SUCCESS",
+    "entities": {
+        "sub_jobs": [
+            {
+                "status": "SUCCESS",
+                "entities": {"volume_id": "591a2fb2-5f46-4b99-9653-b9f06c24e63d"},
+                "job_id": "sub-job-1",
+                "job_type": "createVolume"
+            },
+            {
+                "status": "SUCCESS",
+                "entities": {"volume_id": "6e9fc954-cb78-4f38-9d2b-1d1e1e6a6b65"},
+                "job_id": "sub-job-2",
+                "job_type": "createVolume"
+            }
+        ]
+    },
+    "job_id": "` + JobID + `",
+    "job_type": "createVolume",
+    "begin_time": "2021-01-01T00:00:00Z",
+    "end_time": "2021-01-01T00:01:00Z"
+}`
+
+// MockJobBatchSuccess registers a handler that reports the job as SUCCESS
+// with two sub jobs, as returned by a batch Create with count > 1.
+func MockJobBatchSuccess(t *testing.T) {
+	mockJobGet(t, jobBatchSuccessBody)
+}
+
+// MockVolumeCreate registers a handler for the Create endpoint that always
+// returns the fixed JobID. The test client's ServiceClient has an
+// unversioned base, so this is registered at the same path as the other
+// handlers in this package rather than under a rewritten "/v2.1/" prefix.
+func MockVolumeCreate(t *testing.T) {
+	th.Mux.HandleFunc("/volumes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprintf(w, `{"job_id": "%s"}`, JobID)
+	})
+}
+
+func mockJobGet(t *testing.T, body string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/jobs/%s", JobID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, body)
+	})
+}
+
+// MockJobSuccess registers a handler that always reports the job as SUCCESS.
+func MockJobSuccess(t *testing.T) {
+	mockJobGet(t, jobSuccessBody)
+}
+
+// MockJobFail registers a handler that always reports the job as FAILed.
+func MockJobFail(t *testing.T) {
+	mockJobGet(t, jobFailBody)
+}
+
+// MockJobRunningThenSuccess registers a handler that reports the job as
+// RUNNING on the first call and SUCCESS on every call after that.
+func MockJobRunningThenSuccess(t *testing.T) {
+	calls := 0
+	th.Mux.HandleFunc(fmt.Sprintf("/jobs/%s", JobID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		body := jobSuccessBody
+		if calls == 0 {
+			body = jobRunningBody
+		}
+		calls++
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, body)
+	})
+}
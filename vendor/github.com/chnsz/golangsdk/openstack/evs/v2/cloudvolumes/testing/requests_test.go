@@ -0,0 +1,83 @@
+package testing
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	th "github.com/chnsz/golangsdk/testhelper"
+	"github.com/chnsz/golangsdk/testhelper/client"
+
+	"github.com/chnsz/golangsdk/openstack/evs/v2/cloudvolumes"
+)
+
+func TestGetJob(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	MockJobSuccess(t)
+
+	job, err := cloudvolumes.GetJob(client.ServiceClient(), JobID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "SUCCESS", job.Status)
+	th.AssertEquals(t, "591a2fb2-5f46-4b99-9653-b9f06c24e63d", job.Entities.VolumeID)
+}
+
+func TestWaitForJobSuccess(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	MockJobRunningThenSuccess(t)
+
+	original := cloudvolumes.JobPollInterval
+	cloudvolumes.JobPollInterval = time.Millisecond
+	defer func() { cloudvolumes.JobPollInterval = original }()
+
+	job, err := cloudvolumes.WaitForJobSuccess(client.ServiceClient(), JobID, 10*time.Second)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "591a2fb2-5f46-4b99-9653-b9f06c24e63d", job.Entities.VolumeID)
+}
+
+func TestCreateBatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	MockVolumeCreate(t)
+	MockJobBatchSuccess(t)
+
+	original := cloudvolumes.JobPollInterval
+	cloudvolumes.JobPollInterval = time.Millisecond
+	defer func() { cloudvolumes.JobPollInterval = original }()
+
+	opts := cloudvolumes.CreateOpts{
+		Volume: cloudvolumes.VolumeOpts{
+			AvailabilityZone: "eu-de-01",
+			VolumeType:       "SSD",
+			Size:             10,
+			Count:            2,
+		},
+	}
+
+	volumeIDs, err := cloudvolumes.CreateBatch(client.ServiceClient(), opts)
+	th.AssertNoErr(t, err)
+
+	expected := []string{
+		"591a2fb2-5f46-4b99-9653-b9f06c24e63d",
+		"6e9fc954-cb78-4f38-9d2b-1d1e1e6a6b65",
+	}
+	if !reflect.DeepEqual(expected, volumeIDs) {
+		t.Fatalf("expected volume IDs %v, got %v", expected, volumeIDs)
+	}
+}
+
+func TestWaitForJobSuccessFail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	MockJobFail(t)
+
+	_, err := cloudvolumes.WaitForJobSuccess(client.ServiceClient(), JobID, 10*time.Second)
+	if err == nil {
+		t.Fatal("expected WaitForJobSuccess to return an error")
+	}
+	if !strings.Contains(err.Error(), "quota exceeded") {
+		t.Fatalf("expected error to contain the job's fail_reason, got: %s", err)
+	}
+}
@@ -0,0 +1,43 @@
+package cloudvolumes
+
+import "github.com/chnsz/golangsdk"
+
+func createURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("volumes")
+}
+
+func resourceURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("volumes", id)
+}
+
+func listURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("volumes", "detail")
+}
+
+func actionURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("volumes", id, "action")
+}
+
+func jobURL(c *golangsdk.ServiceClient, jobID string) string {
+	return c.ServiceURL("jobs", jobID)
+}
+
+func metadataURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("volumes", id, "metadata")
+}
+
+func metadataItemURL(c *golangsdk.ServiceClient, id, key string) string {
+	return c.ServiceURL("volumes", id, "metadata", key)
+}
+
+func tagsURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("os-vendor-tags", "volumes", id, "tags")
+}
+
+func tagsActionURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("os-vendor-tags", "volumes", id, "tags", "action")
+}
+
+func allTagsURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("os-vendor-tags", "volumes", "tags")
+}
@@ -0,0 +1,101 @@
+package dnatrules
+
+import (
+	"github.com/chnsz/golangsdk"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToDnatRuleCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains options for creating a DNAT rule. This object is
+// passed to the dnatrules.Create function.
+type CreateOpts struct {
+	// The ID of the NAT gateway the rule belongs to.
+	NatGatewayID string `json:"nat_gateway_id" required:"true"`
+	// The ID of the floating IP used by the rule.
+	FloatingIpID string `json:"floating_ip_id" required:"true"`
+	// The protocol the rule applies to, one of tcp, udp, or any.
+	Protocol string `json:"protocol" required:"true"`
+	// The port the internal service listens on.
+	InternalServicePort int `json:"internal_service_port,omitempty"`
+	// The port the external service is exposed on.
+	ExternalServicePort int `json:"external_service_port,omitempty"`
+	// The ID of the port the rule forwards traffic to. Mutually exclusive with PrivateIp.
+	PortID string `json:"port_id,omitempty"`
+	// The private IP address the rule forwards traffic to. Mutually exclusive with PortID.
+	PrivateIp string `json:"private_ip,omitempty"`
+	// The description of the DNAT rule.
+	Description string `json:"description,omitempty"`
+}
+
+// ToDnatRuleCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToDnatRuleCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "dnat_rule")
+}
+
+// Create will create a new DNAT rule based on the values in CreateOpts.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToDnatRuleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// Get retrieves the DNAT rule with the provided ID. To extract the
+// DnatRule object from the response, call the Extract method on the
+// GetResult.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToDnatRuleUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains options for updating an existing DNAT rule. This
+// object is passed to the dnatrules.Update function.
+type UpdateOpts struct {
+	Protocol            string  `json:"protocol,omitempty"`
+	InternalServicePort *int    `json:"internal_service_port,omitempty"`
+	ExternalServicePort *int    `json:"external_service_port,omitempty"`
+	Description         *string `json:"description,omitempty"`
+}
+
+// ToDnatRuleUpdateMap assembles a request body based on the contents of an
+// UpdateOpts.
+func (opts UpdateOpts) ToDnatRuleUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "dnat_rule")
+}
+
+// Update will update the DNAT rule with the provided information. To
+// extract the updated DnatRule from the response, call the Extract method
+// on the UpdateResult.
+func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToDnatRuleUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete will permanently delete the DNAT rule with the provided ID.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, id), nil)
+	return
+}
@@ -0,0 +1,68 @@
+package dnatrules
+
+import "github.com/chnsz/golangsdk"
+
+// DnatRule represents a DNAT rule as returned by the VPC v2 NAT API.
+type DnatRule struct {
+	// The UUID of the DNAT rule.
+	ID string `json:"id"`
+	// The ID of the NAT gateway the rule belongs to.
+	NatGatewayID string `json:"nat_gateway_id"`
+	// The ID of the floating IP used by the rule.
+	FloatingIpID string `json:"floating_ip_id"`
+	// The protocol the rule applies to.
+	Protocol string `json:"protocol"`
+	// The port the internal service listens on.
+	InternalServicePort int `json:"internal_service_port"`
+	// The port the external service is exposed on.
+	ExternalServicePort int `json:"external_service_port"`
+	// The ID of the port the rule forwards traffic to.
+	PortID string `json:"port_id"`
+	// The private IP address the rule forwards traffic to.
+	PrivateIp string `json:"private_ip"`
+	// The description of the DNAT rule.
+	Description string `json:"description"`
+	// The current status of the DNAT rule.
+	Status string `json:"status"`
+	// The ID of the enterprise project the rule belongs to.
+	ProjectID string `json:"project_id"`
+	// The time the DNAT rule was created.
+	CreatedAt string `json:"created_at"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts a DNAT rule.
+func (r commonResult) Extract() (*DnatRule, error) {
+	var s struct {
+		DnatRule DnatRule `json:"dnat_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.DnatRule, err
+}
+
+// CreateResult represents the result of a create operation. Call its
+// Extract method to interpret it as a DnatRule.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a DnatRule.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its
+// Extract method to interpret it as a DnatRule.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
@@ -0,0 +1,97 @@
+package natgateways
+
+import (
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/common/structs"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToNatGatewayCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains options for creating a NAT gateway. This object is
+// passed to the natgateways.Create function.
+type CreateOpts struct {
+	Name              string `json:"name" required:"true"`
+	Description       string `json:"description,omitempty"`
+	Spec              string `json:"spec" required:"true"`
+	TenantID          string `json:"tenant_id,omitempty"`
+	RouterID          string `json:"router_id" required:"true"`
+	InternalNetworkID string `json:"internal_network_id" required:"true"`
+	// EnterpriseProjectID is the ID of the enterprise project the gateway
+	// belongs to.
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+	// ChargeInfo carries the prePaid billing parameters (charging_mode,
+	// period_type, period_num, is_auto_renew, is_auto_pay) for the gateway.
+	ChargeInfo *structs.ChargeInfo `json:"bssParam,omitempty"`
+}
+
+// ToNatGatewayCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToNatGatewayCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "nat_gateway")
+}
+
+// Create will create a new NAT gateway based on the values in CreateOpts.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToNatGatewayCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// Get retrieves the NAT gateway with the provided ID. To extract the
+// NatGateway object from the response, call the Extract method on the
+// GetResult.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToNatGatewayUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains options for updating an existing NAT gateway. This
+// object is passed to the natgateways.Update function.
+type UpdateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Spec        string `json:"spec,omitempty"`
+}
+
+// ToNatGatewayUpdateMap assembles a request body based on the contents of
+// an UpdateOpts.
+func (opts UpdateOpts) ToNatGatewayUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "nat_gateway")
+}
+
+// Update will update the NAT gateway with the provided information. To
+// extract the updated NatGateway from the response, call the Extract
+// method on the UpdateResult.
+func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToNatGatewayUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete will permanently delete the NAT gateway with the provided ID.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, id), nil)
+	return
+}
@@ -0,0 +1,55 @@
+package natgateways
+
+import "github.com/chnsz/golangsdk"
+
+// NatGateway represents a VPC NAT gateway as returned by the VPC v2 NAT
+// API.
+type NatGateway struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	Description         string `json:"description"`
+	Spec                string `json:"spec"`
+	Status              string `json:"status"`
+	TenantID            string `json:"tenant_id"`
+	RouterID            string `json:"router_id"`
+	InternalNetworkID   string `json:"internal_network_id"`
+	EnterpriseProjectID string `json:"enterprise_project_id"`
+	CreatedAt           string `json:"created_at"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a commonResult as a NatGateway.
+func (r commonResult) Extract() (*NatGateway, error) {
+	var s struct {
+		NatGateway NatGateway `json:"nat_gateway"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.NatGateway, err
+}
+
+// CreateResult represents the result of a create operation. Call its
+// Extract method to interpret it as a NatGateway.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a NatGateway.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its
+// Extract method to interpret it as a NatGateway.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
@@ -0,0 +1,52 @@
+package tags
+
+import "github.com/chnsz/golangsdk"
+
+// Tag represents a single key/value tag attached to a NAT gateway.
+type Tag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// BatchOptsBuilder allows extensions to add additional parameters to the
+// Action request.
+type BatchOptsBuilder interface {
+	ToTagsActionMap() (map[string]interface{}, error)
+}
+
+// BatchOpts contains options for creating or deleting a batch of tags on a
+// NAT gateway. This object is passed to the tags.Action function.
+type BatchOpts struct {
+	// Action is either "create" or "delete".
+	Action string `json:"action" required:"true"`
+	Tags   []Tag  `json:"tags" required:"true"`
+}
+
+// ToTagsActionMap assembles a request body based on the contents of a
+// BatchOpts.
+func (opts BatchOpts) ToTagsActionMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Action creates or deletes the tags on the NAT gateway with the provided
+// ID, depending on opts.Action, via the batch tag action endpoint.
+func Action(client *golangsdk.ServiceClient, natGatewayID string, opts BatchOptsBuilder) (r golangsdk.ErrResult) {
+	b, err := opts.ToTagsActionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, natGatewayID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
+
+// Get retrieves the tags currently attached to the NAT gateway with the
+// provided ID.
+func Get(client *golangsdk.ServiceClient, natGatewayID string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, natGatewayID), &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
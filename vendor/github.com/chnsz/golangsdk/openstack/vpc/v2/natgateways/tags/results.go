@@ -0,0 +1,18 @@
+package tags
+
+import "github.com/chnsz/golangsdk"
+
+// GetResult represents the result of a Get operation. Call its Extract
+// method to interpret it as a slice of Tag.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a GetResult as a slice of Tag.
+func (r GetResult) Extract() ([]Tag, error) {
+	var s struct {
+		Tags []Tag `json:"tags"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Tags, err
+}
@@ -0,0 +1,11 @@
+package tags
+
+import "github.com/chnsz/golangsdk"
+
+func actionURL(c *golangsdk.ServiceClient, natGatewayID string) string {
+	return c.ServiceURL("nat_gateways", natGatewayID, "tags", "action")
+}
+
+func getURL(c *golangsdk.ServiceClient, natGatewayID string) string {
+	return c.ServiceURL("nat_gateways", natGatewayID, "tags")
+}
@@ -0,0 +1,91 @@
+package snatrules
+
+import (
+	"github.com/chnsz/golangsdk"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToSnatRuleCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains options for creating a SNAT rule. This object is
+// passed to the snatrules.Create function.
+type CreateOpts struct {
+	// The ID of the NAT gateway the rule belongs to.
+	NatGatewayID string `json:"nat_gateway_id" required:"true"`
+	// The ID of the floating IP used by the rule.
+	FloatingIpID string `json:"floating_ip_id" required:"true"`
+	// The ID of the subnet the rule applies to. Mutually exclusive with Cidr.
+	NetworkID string `json:"network_id,omitempty"`
+	// The CIDR the rule applies to. Mutually exclusive with NetworkID.
+	Cidr string `json:"cidr,omitempty"`
+	// The description of the SNAT rule.
+	Description string `json:"description,omitempty"`
+}
+
+// ToSnatRuleCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToSnatRuleCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "snat_rule")
+}
+
+// Create will create a new SNAT rule based on the values in CreateOpts.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToSnatRuleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// Get retrieves the SNAT rule with the provided ID. To extract the SnatRule
+// object from the response, call the Extract method on the GetResult.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToSnatRuleUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains options for updating an existing SNAT rule. This
+// object is passed to the snatrules.Update function.
+type UpdateOpts struct {
+	Description *string `json:"description,omitempty"`
+}
+
+// ToSnatRuleUpdateMap assembles a request body based on the contents of an
+// UpdateOpts.
+func (opts UpdateOpts) ToSnatRuleUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "snat_rule")
+}
+
+// Update will update the SNAT rule with the provided information. To
+// extract the updated SnatRule from the response, call the Extract method
+// on the UpdateResult.
+func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToSnatRuleUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete will permanently delete the SNAT rule with the provided ID.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, id), nil)
+	return
+}
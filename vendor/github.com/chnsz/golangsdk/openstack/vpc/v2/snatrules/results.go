@@ -0,0 +1,62 @@
+package snatrules
+
+import "github.com/chnsz/golangsdk"
+
+// SnatRule represents a SNAT rule as returned by the VPC v2 NAT API.
+type SnatRule struct {
+	// The UUID of the SNAT rule.
+	ID string `json:"id"`
+	// The ID of the NAT gateway the rule belongs to.
+	NatGatewayID string `json:"nat_gateway_id"`
+	// The ID of the floating IP used by the rule.
+	FloatingIpID string `json:"floating_ip_id"`
+	// The ID of the subnet the rule applies to.
+	NetworkID string `json:"network_id"`
+	// The CIDR the rule applies to.
+	Cidr string `json:"cidr"`
+	// The description of the SNAT rule.
+	Description string `json:"description"`
+	// The current status of the SNAT rule.
+	Status string `json:"status"`
+	// The ID of the enterprise project the rule belongs to.
+	ProjectID string `json:"project_id"`
+	// The time the SNAT rule was created.
+	CreatedAt string `json:"created_at"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts a SNAT rule.
+func (r commonResult) Extract() (*SnatRule, error) {
+	var s struct {
+		SnatRule SnatRule `json:"snat_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.SnatRule, err
+}
+
+// CreateResult represents the result of a create operation. Call its
+// Extract method to interpret it as a SnatRule.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a SnatRule.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its
+// Extract method to interpret it as a SnatRule.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}